@@ -0,0 +1,42 @@
+package sig
+
+import (
+	"runtime"
+	"sync"
+)
+
+type callerInfo struct {
+	function string
+	file     string
+	line     int
+}
+
+var callerCache sync.Map
+
+func capturePC(skip int) uintptr {
+	var pcs [1]uintptr
+	n := runtime.Callers(skip+2, pcs[:])
+	if n < 1 {
+		return 0
+	}
+	return pcs[0]
+}
+
+func resolveCaller(pc uintptr) callerInfo {
+	if pc == 0 {
+		return callerInfo{}
+	}
+	if v, ok := callerCache.Load(pc); ok {
+		return v.(callerInfo)
+	}
+	frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+	info := callerInfo{function: frame.Function, file: frame.File, line: frame.Line}
+	actual, _ := callerCache.LoadOrStore(pc, info)
+	return actual.(callerInfo)
+}
+
+func WithCallerDepth(depth int) Option {
+	return func() {
+		global.callerDepth = depth
+	}
+}
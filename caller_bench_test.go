@@ -0,0 +1,41 @@
+package sig
+
+import "testing"
+
+func BenchmarkCapturePC(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = capturePC(0)
+	}
+}
+
+func BenchmarkResolveCallerUncached(b *testing.B) {
+	b.ReportAllocs()
+	pcs := make([]uintptr, b.N)
+	for i := range pcs {
+		pcs[i] = capturePC(0)
+		callerCache.Delete(pcs[i])
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		callerCache.Delete(pcs[i])
+		_ = resolveCaller(pcs[i])
+	}
+}
+
+func BenchmarkResolveCallerCached(b *testing.B) {
+	b.ReportAllocs()
+	pc := capturePC(0)
+	resolveCaller(pc)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = resolveCaller(pc)
+	}
+}
+
+func BenchmarkCallerMeta(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = callerMeta()
+	}
+}
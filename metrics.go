@@ -0,0 +1,94 @@
+package sig
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	ometric "go.opentelemetry.io/otel/metric"
+)
+
+func metricAttrs(attrsSlice []Map) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{}
+	for _, m := range attrsSlice {
+		for key, value := range m {
+			attrs = append(attrs, otraceAttr(key, value))
+		}
+	}
+	return attrs
+}
+
+func counterFor(name string) (ometric.Int64Counter, error) {
+	if v, ok := global.counters.Load(name); ok {
+		return v.(ometric.Int64Counter), nil
+	}
+	counter, err := global.meter.Int64Counter(name)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := global.counters.LoadOrStore(name, counter)
+	return actual.(ometric.Int64Counter), nil
+}
+
+func histogramFor(name string) (ometric.Float64Histogram, error) {
+	if v, ok := global.histograms.Load(name); ok {
+		return v.(ometric.Float64Histogram), nil
+	}
+	histogram, err := global.meter.Float64Histogram(name)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := global.histograms.LoadOrStore(name, histogram)
+	return actual.(ometric.Float64Histogram), nil
+}
+
+func gaugeFor(name string) (ometric.Int64UpDownCounter, error) {
+	if v, ok := global.gauges.Load(name); ok {
+		return v.(ometric.Int64UpDownCounter), nil
+	}
+	gauge, err := global.meter.Int64UpDownCounter(name)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := global.gauges.LoadOrStore(name, gauge)
+	return actual.(ometric.Int64UpDownCounter), nil
+}
+
+func (log *log) Counter(name string, value int64, attrs ...Map) {
+	if !global.ok.meter {
+		return
+	}
+	counter, err := counterFor(name)
+	if err != nil {
+		return
+	}
+	counter.Add(log.ctx, value, ometric.WithAttributes(metricAttrs(attrs)...))
+}
+
+func (log *log) Histogram(name string, value float64, attrs ...Map) {
+	if !global.ok.meter {
+		return
+	}
+	histogram, err := histogramFor(name)
+	if err != nil {
+		return
+	}
+	histogram.Record(log.ctx, value, ometric.WithAttributes(metricAttrs(attrs)...))
+}
+
+func (log *log) Gauge(name string, value int64, attrs ...Map) {
+	if !global.ok.meter {
+		return
+	}
+	gauge, err := gaugeFor(name)
+	if err != nil {
+		return
+	}
+	gauge.Add(log.ctx, value, ometric.WithAttributes(metricAttrs(attrs)...))
+}
+
+func (log *log) Timer(name string) func() {
+	start := time.Now()
+	return func() {
+		log.Histogram(name, time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,112 @@
+package sig
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	olog "go.opentelemetry.io/otel/log"
+	ometric "go.opentelemetry.io/otel/metric"
+	otrace "go.opentelemetry.io/otel/trace"
+)
+
+type Sampler func(ctx context.Context, event string, severity olog.Severity, attrs Map) bool
+
+type Option func()
+
+func WithMinSeverity(severity olog.Severity) Option {
+	return func() {
+		global.minSeverity = severity
+	}
+}
+
+func WithSampler(sampler Sampler) Option {
+	return func() {
+		global.sampler = sampler
+	}
+}
+
+func WithRateLimit(n int, per time.Duration) Option {
+	return func() {
+		global.rateLimiter = newRateLimiter(n, per)
+	}
+}
+
+func SetupWithOptions(tracer otrace.Tracer, meter ometric.Meter, logger olog.Logger, options ...Option) {
+	Setup(tracer, meter, logger)
+	for _, option := range options {
+		option()
+	}
+}
+
+func gateAllowed(ctx context.Context, function, event string, severity olog.Severity, attrsSlice []Map) bool {
+	if global.minSeverity != 0 && severity < global.minSeverity {
+		return false
+	}
+	if global.sampler != nil && !global.sampler(ctx, event, severity, mergeMaps(attrsSlice...)) {
+		return false
+	}
+	if global.rateLimiter != nil && !global.rateLimiter.allow(function, severity) {
+		return false
+	}
+	return true
+}
+
+func mergeMaps(maps ...Map) Map {
+	switch len(maps) {
+	case 0:
+		return nil
+	case 1:
+		return maps[0]
+	}
+	merged := Map{}
+	for _, m := range maps {
+		for key, value := range m {
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+type rateLimiter struct {
+	n       float64
+	per     time.Duration
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+func newRateLimiter(n int, per time.Duration) *rateLimiter {
+	return &rateLimiter{
+		n:       float64(n),
+		per:     per,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+func (r *rateLimiter) allow(function string, severity olog.Severity) bool {
+	key := function + "|" + severity.String()
+	now := time.Now()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	bucket, ok := r.buckets[key]
+	if !ok {
+		r.buckets[key] = &tokenBucket{tokens: r.n - 1, last: now}
+		return true
+	}
+	elapsed := now.Sub(bucket.last)
+	bucket.last = now
+	bucket.tokens += elapsed.Seconds() / r.per.Seconds() * r.n
+	if bucket.tokens > r.n {
+		bucket.tokens = r.n
+	}
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
@@ -3,7 +3,8 @@ package sig
 import (
 	"context"
 	"fmt"
-	"runtime"
+	"regexp"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -19,18 +20,120 @@ var global struct {
 		meter  bool
 		logger bool
 	}
-	tracer otrace.Tracer
-	meter  ometric.Meter
-	logger olog.Logger
+	tracer        otrace.Tracer
+	meter         ometric.Meter
+	logger        olog.Logger
+	minSeverity   olog.Severity
+	sampler       Sampler
+	rateLimiter   *rateLimiter
+	counters      sync.Map
+	histograms    sync.Map
+	gauges        sync.Map
+	skipFunctions map[string]bool
+	skipPattern   *regexp.Regexp
+	callerDepth   int
 }
 
 type Map map[string]any
 
+func ologValue(value any) olog.Value {
+	switch v := value.(type) {
+	case nil:
+		return olog.Value{}
+	case bool:
+		return olog.BoolValue(v)
+	case string:
+		return olog.StringValue(v)
+	case []byte:
+		return olog.BytesValue(v)
+	case int:
+		return olog.Int64Value(int64(v))
+	case int32:
+		return olog.Int64Value(int64(v))
+	case int64:
+		return olog.Int64Value(v)
+	case float32:
+		return olog.Float64Value(float64(v))
+	case float64:
+		return olog.Float64Value(v)
+	case Map:
+		kvs := make([]olog.KeyValue, 0, len(v))
+		for key, item := range v {
+			kvs = append(kvs, olog.KeyValue{Key: key, Value: ologValue(item)})
+		}
+		return olog.MapValue(kvs...)
+	case map[string]any:
+		return ologValue(Map(v))
+	case []bool:
+		values := make([]olog.Value, len(v))
+		for i, item := range v {
+			values[i] = olog.BoolValue(item)
+		}
+		return olog.SliceValue(values...)
+	case []string:
+		values := make([]olog.Value, len(v))
+		for i, item := range v {
+			values[i] = olog.StringValue(item)
+		}
+		return olog.SliceValue(values...)
+	case []int64:
+		values := make([]olog.Value, len(v))
+		for i, item := range v {
+			values[i] = olog.Int64Value(item)
+		}
+		return olog.SliceValue(values...)
+	case []float64:
+		values := make([]olog.Value, len(v))
+		for i, item := range v {
+			values[i] = olog.Float64Value(item)
+		}
+		return olog.SliceValue(values...)
+	case []any:
+		values := make([]olog.Value, len(v))
+		for i, item := range v {
+			values[i] = ologValue(item)
+		}
+		return olog.SliceValue(values...)
+	default:
+		return olog.StringValue(fmt.Sprint(value))
+	}
+}
+
+func otraceAttr(key string, value any) attribute.KeyValue {
+	switch v := value.(type) {
+	case bool:
+		return attribute.Bool(key, v)
+	case string:
+		return attribute.String(key, v)
+	case int:
+		return attribute.Int64(key, int64(v))
+	case int32:
+		return attribute.Int64(key, int64(v))
+	case int64:
+		return attribute.Int64(key, v)
+	case float32:
+		return attribute.Float64(key, float64(v))
+	case float64:
+		return attribute.Float64(key, v)
+	case []bool:
+		return attribute.BoolSlice(key, v)
+	case []string:
+		return attribute.StringSlice(key, v)
+	case []int64:
+		return attribute.Int64Slice(key, v)
+	case []float64:
+		return attribute.Float64Slice(key, v)
+	default:
+		return attribute.String(key, fmt.Sprint(value))
+	}
+}
+
 type log struct {
-	function string
-	file     string
-	ctx      context.Context
-	span     otrace.Span
+	function   string
+	file       string
+	ctx        context.Context
+	span       otrace.Span
+	spanActive bool
 }
 
 type Log interface {
@@ -40,6 +143,10 @@ type Log interface {
 	Warn(string, ...Map)
 	Error(error, ...Map)
 	Fatal(error, ...Map)
+	Counter(string, int64, ...Map)
+	Histogram(string, float64, ...Map)
+	Gauge(string, int64, ...Map)
+	Timer(string) func()
 	End()
 	Ctx() context.Context
 }
@@ -60,19 +167,12 @@ func Setup(tracer otrace.Tracer, meter ometric.Meter, logger olog.Logger) {
 }
 
 func callerMeta() (string, string, int) {
-	pc, file, line, ok := runtime.Caller(2)
-	if !ok {
-		return "", "", 0
-	}
-	return runtime.FuncForPC(pc).Name(), file, line
+	info := resolveCaller(capturePC(2 + global.callerDepth))
+	return info.function, info.file, info.line
 }
 
 func callerLine(skip int) int {
-	_, _, line, ok := runtime.Caller(skip)
-	if !ok {
-		return 0
-	}
-	return line
+	return resolveCaller(capturePC(skip + global.callerDepth)).line
 }
 
 func Start(ctx context.Context) Log {
@@ -83,6 +183,9 @@ func Start(ctx context.Context) Log {
 	now := time.Now()
 	var line int
 	log.function, log.file, line = callerMeta()
+	if skipped(log.function) {
+		return &noopLog{ctx: ctx}
+	}
 	if global.ok.tracer {
 		log.ctx, log.span = global.tracer.Start(
 			ctx,
@@ -93,8 +196,9 @@ func Start(ctx context.Context) Log {
 				attribute.Int("line", line),
 			),
 		)
+		log.spanActive = true
 	}
-	if global.ok.logger {
+	if global.ok.logger && gateAllowed(log.ctx, log.function, "started", olog.SeverityTrace, nil) {
 		record := olog.Record{}
 		record.SetBody(olog.StringValue("started"))
 		record.SetTimestamp(now)
@@ -119,8 +223,8 @@ func (log *log) End() {
 		return
 	}
 	now := time.Now()
-	line := callerLine(2)
-	if global.ok.logger {
+	if global.ok.logger && gateAllowed(log.ctx, log.function, "ended", olog.SeverityTrace, nil) {
+		line := callerLine(2)
 		record := olog.Record{}
 		record.SetBody(olog.StringValue("ended"))
 		record.SetTimestamp(now)
@@ -133,16 +237,19 @@ func (log *log) End() {
 		)
 		global.logger.Emit(log.ctx, record)
 	}
-	if global.ok.tracer {
+	if log.spanActive {
 		log.span.End(otrace.WithTimestamp(now))
 	}
 }
 
 func (log *log) record(event string, level olog.Severity, attrsSlice ...Map) {
+	if !gateAllowed(log.ctx, log.function, event, level, attrsSlice) {
+		return
+	}
 	now := time.Now()
 	var otraceAttrs []attribute.KeyValue
 	var ologAttrs []olog.KeyValue
-	if global.ok.tracer {
+	if global.ok.tracer && log.spanActive {
 		otraceAttrs = []attribute.KeyValue{}
 	}
 	if global.ok.logger {
@@ -150,18 +257,16 @@ func (log *log) record(event string, level olog.Severity, attrsSlice ...Map) {
 	}
 	for _, attrs := range attrsSlice {
 		for key, value := range attrs {
-			if global.ok.tracer {
-				otraceAttr := attribute.String(key, fmt.Sprint(value))
-				otraceAttrs = append(otraceAttrs, otraceAttr)
+			if global.ok.tracer && log.spanActive {
+				otraceAttrs = append(otraceAttrs, otraceAttr(key, value))
 			}
 			if global.ok.logger {
-				ologAttr := olog.String(key, fmt.Sprint(value))
-				ologAttrs = append(ologAttrs, ologAttr)
+				ologAttrs = append(ologAttrs, olog.KeyValue{Key: key, Value: ologValue(value)})
 			}
 		}
 	}
 	line := callerLine(3)
-	if global.ok.tracer {
+	if global.ok.tracer && log.spanActive {
 		otraceAttrs = append(
 			otraceAttrs,
 			attribute.String("file", log.file),
@@ -189,6 +294,7 @@ func (log *log) record(event string, level olog.Severity, attrsSlice ...Map) {
 			olog.String("file", log.file),
 			olog.Int("line", line),
 		)
+		record.AddAttributes(ologAttrs...)
 		global.logger.Emit(log.ctx, record)
 	}
 }
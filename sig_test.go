@@ -0,0 +1,178 @@
+package sig
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	olog "go.opentelemetry.io/otel/log"
+	ometric "go.opentelemetry.io/otel/metric"
+	noopmetric "go.opentelemetry.io/otel/metric/noop"
+	otrace "go.opentelemetry.io/otel/trace"
+)
+
+func noopTracer() otrace.Tracer {
+	return otrace.NewNoopTracerProvider().Tracer("test")
+}
+
+func findRecord(records []olog.Record, body string) (olog.Record, bool) {
+	for _, record := range records {
+		if record.Body().AsString() == body {
+			return record, true
+		}
+	}
+	return olog.Record{}, false
+}
+
+func TestStartCreatesSpanWhenMinSeverityFiltersStartedEvent(t *testing.T) {
+	resetGlobalForTest()
+	exporter := &memoryLogger{}
+	SetupWithOptions(noopTracer(), nil, exporter, WithMinSeverity(olog.SeverityInfo))
+	defer resetGlobalForTest()
+
+	started := Start(context.Background())
+	concrete, ok := started.(*log)
+	if !ok {
+		t.Fatalf("expected *log, got %T", started)
+	}
+	if !concrete.spanActive {
+		t.Fatal("expected span to be created despite minSeverity filtering the started event")
+	}
+	if _, found := findRecord(exporter.records, "started"); found {
+		t.Fatal("expected started event to be filtered by minSeverity")
+	}
+
+	started.End()
+	if _, found := findRecord(exporter.records, "ended"); found {
+		t.Fatal("expected ended event to be filtered by minSeverity")
+	}
+}
+
+func TestStartCreatesSpanWhenRateLimiterDeniesStartedEvent(t *testing.T) {
+	resetGlobalForTest()
+	exporter := &memoryLogger{}
+	SetupWithOptions(noopTracer(), nil, exporter, WithRateLimit(0, time.Minute))
+	defer resetGlobalForTest()
+
+	// The first call always seeds the bucket and is allowed, so the rate
+	// limiter only starts denying "started" events from the second call on.
+	Start(context.Background())
+	exporter.records = nil
+
+	started := Start(context.Background())
+	concrete, ok := started.(*log)
+	if !ok {
+		t.Fatalf("expected *log, got %T", started)
+	}
+	if !concrete.spanActive {
+		t.Fatal("expected span to be created despite the rate limiter denying the started event")
+	}
+	if _, found := findRecord(exporter.records, "started"); found {
+		t.Fatal("expected started event to be denied by the rate limiter")
+	}
+}
+
+func TestRecordConvertsTypedAttributeValues(t *testing.T) {
+	resetGlobalForTest()
+	exporter := &memoryLogger{}
+	Setup(nil, nil, exporter)
+	defer resetGlobalForTest()
+
+	log := Start(context.Background())
+	log.Info("typed-attrs", Map{
+		"raw":    []byte("hi"),
+		"nested": Map{"inner": int64(7)},
+		"names":  []string{"a", "b"},
+		"counts": []int64{1, 2, 3},
+		"ratios": []float64{1.5, 2.5},
+		"flags":  []bool{true, false},
+	})
+
+	record, found := findRecord(exporter.records, "typed-attrs")
+	if !found {
+		t.Fatal("expected typed-attrs record to be emitted")
+	}
+	attrs := attrMap(record)
+	if string(attrs["raw"].AsBytes()) != "hi" {
+		t.Fatalf("expected raw=hi, got %v", attrs["raw"])
+	}
+	nested := attrs["nested"].AsMap()
+	if len(nested) != 1 || nested[0].Key != "inner" || nested[0].Value.AsInt64() != 7 {
+		t.Fatalf("unexpected nested map: %v", nested)
+	}
+	names := attrs["names"].AsSlice()
+	if len(names) != 2 || names[0].AsString() != "a" || names[1].AsString() != "b" {
+		t.Fatalf("unexpected names slice: %v", names)
+	}
+	counts := attrs["counts"].AsSlice()
+	if len(counts) != 3 || counts[2].AsInt64() != 3 {
+		t.Fatalf("unexpected counts slice: %v", counts)
+	}
+	ratios := attrs["ratios"].AsSlice()
+	if len(ratios) != 2 || ratios[1].AsFloat64() != 2.5 {
+		t.Fatalf("unexpected ratios slice: %v", ratios)
+	}
+	flags := attrs["flags"].AsSlice()
+	if len(flags) != 2 || !flags[0].AsBool() || flags[1].AsBool() {
+		t.Fatalf("unexpected flags slice: %v", flags)
+	}
+}
+
+func TestMetricsCacheInstrumentsByName(t *testing.T) {
+	resetGlobalForTest()
+	SetupWithOptions(nil, noopmetric.NewMeterProvider().Meter("test"), nil)
+	defer resetGlobalForTest()
+
+	log := Start(context.Background())
+	log.Counter("requests", 1)
+	log.Histogram("latency", 0.5)
+	log.Gauge("inflight", 1)
+	stop := log.Timer("duration")
+	stop()
+
+	if _, ok := global.counters.Load("requests"); !ok {
+		t.Fatal("expected requests counter to be cached")
+	}
+	if _, ok := global.histograms.Load("latency"); !ok {
+		t.Fatal("expected latency histogram to be cached")
+	}
+	if _, ok := global.histograms.Load("duration"); !ok {
+		t.Fatal("expected duration timer to be cached as a histogram")
+	}
+	if _, ok := global.gauges.Load("inflight"); !ok {
+		t.Fatal("expected inflight gauge to be cached")
+	}
+
+	counter, err := counterFor("requests")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := counter.(ometric.Int64Counter); !ok {
+		t.Fatalf("expected cached Int64Counter, got %T", counter)
+	}
+}
+
+func TestSkipFunctionsReturnsNoopLog(t *testing.T) {
+	resetGlobalForTest()
+	SetupWithOptions(noopTracer(), nil, nil, WithSkipFunctions([]string{"github.com/gokpm/go-sig.startFromSkippedHelper"}))
+	defer resetGlobalForTest()
+
+	if _, ok := startFromSkippedHelper().(*noopLog); !ok {
+		t.Fatal("expected skipped function to return a noopLog")
+	}
+}
+
+func TestSkipPatternReturnsNoopLog(t *testing.T) {
+	resetGlobalForTest()
+	SetupWithOptions(noopTracer(), nil, nil, WithSkipPattern(regexp.MustCompile(`startFromSkippedHelper$`)))
+	defer resetGlobalForTest()
+
+	if _, ok := startFromSkippedHelper().(*noopLog); !ok {
+		t.Fatal("expected pattern-matched function to return a noopLog")
+	}
+}
+
+func startFromSkippedHelper() Log {
+	return Start(context.Background())
+}
@@ -0,0 +1,60 @@
+package sigfiber
+
+import (
+	"fmt"
+	"runtime/debug"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/gokpm/go-sig"
+)
+
+var propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+type headerCarrier struct {
+	ctx *fiber.Ctx
+}
+
+func (c headerCarrier) Get(key string) string {
+	return c.ctx.Get(key)
+}
+
+func (c headerCarrier) Set(key, value string) {
+	c.ctx.Set(key, value)
+}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0)
+	c.ctx.Request().Header.VisitAll(func(key, _ []byte) {
+		keys = append(keys, string(key))
+	})
+	return keys
+}
+
+func Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) (err error) {
+		ctx := propagator.Extract(c.Context(), headerCarrier{ctx: c})
+		log := sig.Start(ctx)
+		defer log.End()
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				log.Fatal(fmt.Errorf("panic: %v", recovered), sig.Map{"stack": string(debug.Stack())})
+				err = fiber.ErrInternalServerError
+			}
+		}()
+		log.Info("request", sig.Map{
+			"http.method":      c.Method(),
+			"http.route":       c.Path(),
+			"http.user_agent":  c.Get(fiber.HeaderUserAgent),
+			"http.remote_addr": c.IP(),
+		})
+		c.SetUserContext(log.Ctx())
+		err = c.Next()
+		log.Info("response", sig.Map{"http.status_code": c.Response().StatusCode()})
+		if err != nil {
+			log.Error(err)
+		}
+		return err
+	}
+}
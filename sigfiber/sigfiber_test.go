@@ -0,0 +1,41 @@
+package sigfiber_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	olog "go.opentelemetry.io/otel/log"
+	otrace "go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
+
+	"github.com/gokpm/go-sig"
+	"github.com/gokpm/go-sig/sigfiber"
+)
+
+type recordingTracer struct {
+	embedded.Tracer
+	starts int
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string, opts ...otrace.SpanStartOption) (context.Context, otrace.Span) {
+	t.starts++
+	return otrace.NewNoopTracerProvider().Tracer("test").Start(ctx, name, opts...)
+}
+
+func TestMiddlewareTracesRequestsUnderAggressiveSampling(t *testing.T) {
+	tracer := &recordingTracer{}
+	sig.SetupWithOptions(tracer, nil, nil, sig.WithMinSeverity(olog.SeverityFatal4))
+
+	app := fiber.New()
+	app.Use(sigfiber.Middleware())
+	app.Get("/", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
+
+	if _, err := app.Test(httptest.NewRequest("GET", "/", nil)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tracer.starts != 1 {
+		t.Fatalf("expected the middleware to start exactly one span even when every log event is filtered, got %d", tracer.starts)
+	}
+}
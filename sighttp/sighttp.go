@@ -0,0 +1,46 @@
+package sighttp
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/gokpm/go-sig"
+)
+
+var propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		log := sig.Start(ctx)
+		defer log.End()
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				log.Fatal(fmt.Errorf("panic: %v", recovered), sig.Map{"stack": string(debug.Stack())})
+				sw.WriteHeader(http.StatusInternalServerError)
+			}
+		}()
+		log.Info("request", sig.Map{
+			"http.method":      r.Method,
+			"http.route":       r.URL.Path,
+			"http.user_agent":  r.UserAgent(),
+			"http.remote_addr": r.RemoteAddr,
+		})
+		next.ServeHTTP(sw, r.WithContext(log.Ctx()))
+		log.Info("response", sig.Map{"http.status_code": sw.status})
+	})
+}
@@ -0,0 +1,39 @@
+package sighttp_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	olog "go.opentelemetry.io/otel/log"
+	otrace "go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
+
+	"github.com/gokpm/go-sig"
+	"github.com/gokpm/go-sig/sighttp"
+)
+
+type recordingTracer struct {
+	embedded.Tracer
+	starts int
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string, opts ...otrace.SpanStartOption) (context.Context, otrace.Span) {
+	t.starts++
+	return otrace.NewNoopTracerProvider().Tracer("test").Start(ctx, name, opts...)
+}
+
+func TestMiddlewareTracesRequestsUnderAggressiveSampling(t *testing.T) {
+	tracer := &recordingTracer{}
+	sig.SetupWithOptions(tracer, nil, nil, sig.WithMinSeverity(olog.SeverityFatal4))
+
+	handler := sighttp.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if tracer.starts != 1 {
+		t.Fatalf("expected the middleware to start exactly one span even when every log event is filtered, got %d", tracer.starts)
+	}
+}
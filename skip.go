@@ -0,0 +1,55 @@
+package sig
+
+import (
+	"context"
+	"regexp"
+)
+
+func WithSkipFunctions(functions []string) Option {
+	return func() {
+		skip := make(map[string]bool, len(functions))
+		for _, function := range functions {
+			skip[function] = true
+		}
+		global.skipFunctions = skip
+	}
+}
+
+func WithSkipPattern(pattern *regexp.Regexp) Option {
+	return func() {
+		global.skipPattern = pattern
+	}
+}
+
+func skipped(function string) bool {
+	if global.skipFunctions != nil && global.skipFunctions[function] {
+		return true
+	}
+	if global.skipPattern != nil && global.skipPattern.MatchString(function) {
+		return true
+	}
+	return false
+}
+
+type noopLog struct {
+	ctx context.Context
+}
+
+func (log *noopLog) Trace(string, ...Map) {}
+func (log *noopLog) Info(string, ...Map)  {}
+func (log *noopLog) Debug(string, ...Map) {}
+func (log *noopLog) Warn(string, ...Map)  {}
+func (log *noopLog) Error(error, ...Map)  {}
+func (log *noopLog) Fatal(error, ...Map)  {}
+
+func (log *noopLog) Counter(string, int64, ...Map)     {}
+func (log *noopLog) Histogram(string, float64, ...Map) {}
+func (log *noopLog) Gauge(string, int64, ...Map)       {}
+
+func (log *noopLog) Timer(string) func() { return func() {} }
+
+func (log *noopLog) End() {}
+
+func (log *noopLog) Ctx() context.Context {
+	return log.ctx
+}
@@ -0,0 +1,115 @@
+package sig
+
+import (
+	"context"
+	"log/slog"
+
+	olog "go.opentelemetry.io/otel/log"
+)
+
+type slogHandler struct {
+	group string
+	attrs []olog.KeyValue
+}
+
+func NewSlogHandler() slog.Handler {
+	return &slogHandler{}
+}
+
+func (h *slogHandler) key(key string) string {
+	if h.group == "" {
+		return key
+	}
+	return h.group + "." + key
+}
+
+func (h *slogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	if !global.ok.logger {
+		return false
+	}
+	severity := slogSeverity(level)
+	return global.minSeverity == 0 || severity >= global.minSeverity
+}
+
+func (h *slogHandler) Handle(ctx context.Context, r slog.Record) error {
+	if !global.ok.logger {
+		return nil
+	}
+	severity := slogSeverity(r.Level)
+	function := resolveCaller(r.PC).function
+	sample := Map{}
+	r.Attrs(func(attr slog.Attr) bool {
+		sample[h.key(attr.Key)] = attr.Value.Any()
+		return true
+	})
+	if !gateAllowed(ctx, function, r.Message, severity, []Map{sample}) {
+		return nil
+	}
+	record := olog.Record{}
+	record.SetBody(olog.StringValue(r.Message))
+	record.SetTimestamp(r.Time)
+	record.SetSeverity(severity)
+	record.SetSeverityText(severity.String())
+	record.AddAttributes(h.attrs...)
+	r.Attrs(func(attr slog.Attr) bool {
+		record.AddAttributes(olog.KeyValue{Key: h.key(attr.Key), Value: slogValue(attr.Value)})
+		return true
+	})
+	global.logger.Emit(ctx, record)
+	return nil
+}
+
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	next := &slogHandler{group: h.group, attrs: make([]olog.KeyValue, len(h.attrs), len(h.attrs)+len(attrs))}
+	copy(next.attrs, h.attrs)
+	for _, attr := range attrs {
+		next.attrs = append(next.attrs, olog.KeyValue{Key: h.key(attr.Key), Value: slogValue(attr.Value)})
+	}
+	return next
+}
+
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	next := &slogHandler{group: h.key(name), attrs: h.attrs}
+	return next
+}
+
+func slogSeverity(level slog.Level) olog.Severity {
+	switch {
+	case level >= slog.LevelError:
+		return olog.SeverityError
+	case level >= slog.LevelWarn:
+		return olog.SeverityWarn
+	case level >= slog.LevelInfo:
+		return olog.SeverityInfo
+	default:
+		return olog.SeverityDebug
+	}
+}
+
+func slogValue(value slog.Value) olog.Value {
+	switch value.Kind() {
+	case slog.KindBool:
+		return olog.BoolValue(value.Bool())
+	case slog.KindInt64:
+		return olog.Int64Value(value.Int64())
+	case slog.KindUint64:
+		return olog.Int64Value(int64(value.Uint64()))
+	case slog.KindFloat64:
+		return olog.Float64Value(value.Float64())
+	case slog.KindString:
+		return olog.StringValue(value.String())
+	case slog.KindDuration:
+		return olog.Int64Value(int64(value.Duration()))
+	case slog.KindTime:
+		return olog.StringValue(value.Time().String())
+	case slog.KindGroup:
+		group := value.Group()
+		kvs := make([]olog.KeyValue, len(group))
+		for i, attr := range group {
+			kvs[i] = olog.KeyValue{Key: attr.Key, Value: slogValue(attr.Value)}
+		}
+		return olog.MapValue(kvs...)
+	default:
+		return ologValue(value.Any())
+	}
+}
@@ -0,0 +1,129 @@
+package sig
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	olog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/embedded"
+)
+
+type memoryLogger struct {
+	embedded.Logger
+	records []olog.Record
+}
+
+func (m *memoryLogger) Emit(_ context.Context, record olog.Record) {
+	m.records = append(m.records, record)
+}
+
+func (m *memoryLogger) Enabled(context.Context, olog.Record) bool {
+	return true
+}
+
+func attrMap(record olog.Record) map[string]olog.Value {
+	attrs := make(map[string]olog.Value)
+	record.WalkAttributes(func(kv olog.KeyValue) bool {
+		attrs[kv.Key] = kv.Value
+		return true
+	})
+	return attrs
+}
+
+func resetGlobalForTest() {
+	global.ok.tracer = false
+	global.ok.meter = false
+	global.ok.logger = false
+	global.tracer = nil
+	global.meter = nil
+	global.logger = nil
+	global.minSeverity = 0
+	global.sampler = nil
+	global.rateLimiter = nil
+	global.skipFunctions = nil
+	global.skipPattern = nil
+	global.callerDepth = 0
+}
+
+func TestSlogHandlerEmitsStructuredAttributes(t *testing.T) {
+	resetGlobalForTest()
+	exporter := &memoryLogger{}
+	Setup(nil, nil, exporter)
+	defer resetGlobalForTest()
+
+	logger := slog.New(NewSlogHandler())
+	logger.Info("order placed",
+		slog.Int64("amount", 42),
+		slog.Bool("paid", true),
+		slog.String("currency", "USD"),
+	)
+
+	if len(exporter.records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(exporter.records))
+	}
+	record := exporter.records[0]
+	if record.Body().AsString() != "order placed" {
+		t.Fatalf("unexpected body: %v", record.Body())
+	}
+	if record.Severity() != olog.SeverityInfo {
+		t.Fatalf("unexpected severity: %v", record.Severity())
+	}
+	attrs := attrMap(record)
+	if attrs["amount"].AsInt64() != 42 {
+		t.Fatalf("expected amount=42, got %v", attrs["amount"])
+	}
+	if !attrs["paid"].AsBool() {
+		t.Fatalf("expected paid=true, got %v", attrs["paid"])
+	}
+	if attrs["currency"].AsString() != "USD" {
+		t.Fatalf("expected currency=USD, got %v", attrs["currency"])
+	}
+}
+
+func TestSlogHandlerWithAttrsAndGroup(t *testing.T) {
+	resetGlobalForTest()
+	exporter := &memoryLogger{}
+	Setup(nil, nil, exporter)
+	defer resetGlobalForTest()
+
+	logger := slog.New(NewSlogHandler()).With("request_id", "abc").WithGroup("http")
+	logger.Warn("slow request", slog.Int64("status", 200))
+
+	record := exporter.records[0]
+	attrs := attrMap(record)
+	if attrs["request_id"].AsString() != "abc" {
+		t.Fatalf("expected request_id=abc, got %v", attrs["request_id"])
+	}
+	if attrs["http.status"].AsInt64() != 200 {
+		t.Fatalf("expected http.status=200, got %v", attrs["http.status"])
+	}
+}
+
+func TestSlogHandlerRespectsMinSeverity(t *testing.T) {
+	resetGlobalForTest()
+	exporter := &memoryLogger{}
+	SetupWithOptions(nil, nil, exporter, WithMinSeverity(olog.SeverityWarn))
+	defer resetGlobalForTest()
+
+	logger := slog.New(NewSlogHandler())
+	logger.Info("should be filtered")
+	logger.Error("should pass")
+
+	if len(exporter.records) != 1 {
+		t.Fatalf("expected 1 record after filtering, got %d", len(exporter.records))
+	}
+	if exporter.records[0].Body().AsString() != "should pass" {
+		t.Fatalf("unexpected record passed filter: %v", exporter.records[0].Body())
+	}
+}
+
+func TestSlogHandlerNoopWithoutLogger(t *testing.T) {
+	resetGlobalForTest()
+	defer resetGlobalForTest()
+
+	handler := NewSlogHandler()
+	if handler.Enabled(context.Background(), slog.LevelInfo) {
+		t.Fatal("expected handler disabled without a configured logger")
+	}
+}